@@ -20,7 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"reflect"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -103,11 +103,6 @@ type Informer interface {
 // ObjectSelector is an alias name of internal.Selector.
 type ObjectSelector internal.Selector
 
-// SelectorsByObject associate a client.Object's GVK to a field/label selector.
-// There is also `DefaultSelector` to set a global default (which will be overridden by
-// a more specific setting here, if any).
-type SelectorsByObject map[client.Object]ObjectSelector
-
 // Options are the optional arguments for creating a new InformersMap object.
 type Options struct {
 	// HTTPClient is the http client to use for the REST client
@@ -123,11 +118,56 @@ type Options struct {
 	// Defaults to defaultResyncTime.
 	// A 10 percent jitter will be added to the ResyncEvery period between informers
 	// So that all informers will not send list requests simultaneously.
+	// It can be overridden per-GVK via ByObject[...].ResyncPeriod.
 	ResyncEvery *time.Duration
 
 	// View restricts the cache's ListWatch to the desired fields per GVK
 	// Default watches all fields and all namespaces.
 	View ViewOptions
+
+	// UnsafeDisableDeepCopy is the default value used for ObjectOptions.UnsafeDisableDeepCopy
+	// for any GVK that doesn't have a more specific setting in ByObject.
+	// Be very careful with this, when enabled you must DeepCopy any object before mutating it,
+	// otherwise you will mutate the object in the cache.
+	UnsafeDisableDeepCopy *bool
+
+	// Transform is the default value used for ObjectOptions.Transform for any GVK that doesn't
+	// have a more specific setting in ByObject.
+	//
+	// This function is called both for new objects to enter the cache, and for updated objects.
+	Transform toolscache.TransformFunc
+
+	// ByObject restricts the cache's ListWatch, transform and deep-copy behavior on a per-GVK
+	// basis at the specified object, overriding the defaults above for that object's GVK.
+	ByObject map[client.Object]ObjectOptions
+
+	// NamespaceConfigs allows per-namespace overrides of the selectors, transform,
+	// deep-copy behavior and even rest.Config used to watch a namespace, for use when more
+	// than one namespace is being watched (len(View.Namespaces) > 1, or a ByObject entry sets
+	// its own Namespaces). Namespaces without an entry here use the cache-wide and per-GVK
+	// defaults above.
+	NamespaceConfigs map[string]NamespaceOptions
+}
+
+// NamespaceOptions overrides the cache-wide and per-GVK (ByObject) defaults for a single
+// namespace, for use with Options.NamespaceConfigs.
+type NamespaceOptions struct {
+	// Selectors restricts the cache's ListWatch in this namespace to the desired field/label
+	// selector per GVK, overriding the cache-wide and per-GVK (ByObject) selectors for objects
+	// observed in this namespace.
+	Selectors map[client.Object]ObjectSelector
+
+	// Transform, if set, is called both for new objects in this namespace to enter the cache,
+	// and for updated objects, overriding the cache-wide and per-GVK transform.
+	Transform toolscache.TransformFunc
+
+	// UnsafeDisableDeepCopy, if set, overrides the cache-wide and per-GVK
+	// UnsafeDisableDeepCopy for objects observed in this namespace.
+	UnsafeDisableDeepCopy *bool
+
+	// Config, if set, is the rest.Config used to watch this namespace, overriding the config
+	// passed to New. This is useful for token-per-namespace scenarios.
+	Config *rest.Config
 }
 
 // ViewOptions are the optional arguments for creating a cache view.
@@ -141,72 +181,262 @@ type ViewOptions struct {
 	// DefaultSelector will be used as selectors for all object types
 	// unless they have a more specific selector set in ByObject.
 	DefaultSelector ObjectSelector
-
-	// DefaultTransform will be used as transform for all object types
-	// unless they have a more specific transform set in ByObject.
-	DefaultTransform toolscache.TransformFunc
-
-	// ByObject restricts the cache's ListWatch to the desired fields per GVK at the specified object.
-	ByObject ViewByObject
 }
 
-// ViewByObject offers more fine-grained control over the cache's ListWatch by object.
-type ViewByObject struct {
-	// Selectors restricts the cache's ListWatch to the desired
-	// fields per GVK at the specified object, the map's value must implement
-	// Selectors [1] using for example a Set [2]
-	// [1] https://pkg.go.dev/k8s.io/apimachinery/pkg/fields#Selectors
-	// [2] https://pkg.go.dev/k8s.io/apimachinery/pkg/fields#Set
-	Selectors SelectorsByObject
+// ObjectOptions holds the configurable per-GVK cache behavior that used to be spread
+// across Options.View.ByObject.Selectors, .Transform and .UnsafeDisableDeepCopy.
+type ObjectOptions struct {
+	// Label, if set, restricts the cache's ListWatch to the desired label selector for this GVK.
+	Label labels.Selector
 
-	// Transform is a map from objects to transformer functions which
-	// get applied when objects of the transformation are about to be committed
-	// to cache.
-	//
-	// This function is called both for new objects to enter the cache,
-	// and for updated objects.
-	Transform TransformByObject
+	// Field, if set, restricts the cache's ListWatch to the desired field selector for this GVK.
+	Field fields.Selector
 
-	// UnsafeDisableDeepCopy indicates not to deep copy objects during get or
-	// list objects per GVK at the specified object.
+	// Transform, if set, is called both for new objects of this GVK to enter the cache, and for
+	// updated objects, in place of Options.Transform.
+	Transform toolscache.TransformFunc
+
+	// UnsafeDisableDeepCopy, if set, indicates not to deep copy objects of this GVK during get or
+	// list from the cache, in place of Options.UnsafeDisableDeepCopy.
 	// Be very careful with this, when enabled you must DeepCopy any object before mutating it,
 	// otherwise you will mutate the object in the cache.
-	UnsafeDisableDeepCopy DisableDeepCopyByObject
+	UnsafeDisableDeepCopy *bool
+
+	// Namespaces, if set, restricts the cache's ListWatch for this GVK to the given namespaces,
+	// in place of View.Namespaces. This allows, for example, watching v1.Pod cluster-wide while
+	// restricting v1.Secret to a couple of namespaces, avoiding overly broad RBAC on
+	// high-cardinality types.
+	Namespaces []string
+
+	// ResyncPeriod, if set, is the resync period used for this GVK's informer, in place of
+	// Options.ResyncEvery.
+	ResyncPeriod *time.Duration
 }
 
 var defaultResyncTime = 10 * time.Hour
 
-// New initializes and returns a new Cache.
+// CacheOption configures a Cache under construction by mutating the Options that are
+// passed to newCache. Options are applied in the order given, so a later option always
+// takes precedence over an earlier one for the fields it touches — there is no implicit
+// three-way merge to reason about.
+type CacheOption func(*Options) error
+
+// New initializes and returns a new Cache, built from opts.
 func New(config *rest.Config, opts Options) (Cache, error) {
-	opts, err := defaultOpts(config, opts)
-	if err != nil {
-		return nil, err
+	return newCache(config, opts)
+}
+
+// NewWithCacheOptions initializes and returns a new Cache, built from the given CacheOptions
+// applied in order. Use this instead of New when you want to compose Options out of reusable
+// pieces like WithSelector or WithTransform rather than assembling a single Options value by
+// hand.
+//
+// TODO: Manager still builds its Cache via New(config, Options), not this function. Moving
+// Manager onto NewWithCacheOptions — and letting its own constructor accept a []CacheOption —
+// was part of the original ask but reaches outside this package, so it's left as a tracked
+// follow-up rather than folded in here silently.
+func NewWithCacheOptions(config *rest.Config, opts ...CacheOption) (Cache, error) {
+	var options Options
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+	return newCache(config, options)
+}
+
+// WithOptions returns a CacheOption that sets every field of o onto the Options under
+// construction, discarding whatever any earlier CacheOption set. It lets a NewWithCacheOptions
+// caller fold a pre-assembled Options value into the pipeline, for example to apply a shared
+// base configuration before layering more specific CacheOptions on top.
+func WithOptions(o Options) CacheOption {
+	return func(opts *Options) error {
+		*opts = o
+		return nil
+	}
+}
+
+// WithDefaults returns a CacheOption that fills in any field left unset by the options applied
+// before it with the corresponding field from defaults. Because it only fills gaps, WithDefaults
+// is meant to be applied first, letting a downstream builder layer a base configuration that the
+// caller's own options can still override.
+func WithDefaults(defaults Options) CacheOption {
+	return func(opts *Options) error {
+		if opts.HTTPClient == nil {
+			opts.HTTPClient = defaults.HTTPClient
+		}
+		if opts.Scheme == nil {
+			opts.Scheme = defaults.Scheme
+		}
+		if opts.Mapper == nil {
+			opts.Mapper = defaults.Mapper
+		}
+		if opts.ResyncEvery == nil {
+			opts.ResyncEvery = defaults.ResyncEvery
+		}
+		if len(opts.View.Namespaces) == 0 {
+			opts.View.Namespaces = defaults.View.Namespaces
+		}
+		if opts.View.DefaultSelector.Label == nil && opts.View.DefaultSelector.Field == nil {
+			opts.View.DefaultSelector = defaults.View.DefaultSelector
+		}
+		if opts.UnsafeDisableDeepCopy == nil {
+			opts.UnsafeDisableDeepCopy = defaults.UnsafeDisableDeepCopy
+		}
+		if opts.Transform == nil {
+			opts.Transform = defaults.Transform
+		}
+		if opts.NamespaceConfigs == nil {
+			opts.NamespaceConfigs = defaults.NamespaceConfigs
+		}
+		for obj, o := range defaults.ByObject {
+			o := o
+			if err := opts.setByObject(obj, func(dst *ObjectOptions) {
+				*dst = applyObjectOptionsDefaults(*dst, o)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// WithNamespaces returns a CacheOption that restricts the cache's ListWatch to the given
+// namespaces.
+func WithNamespaces(namespaces ...string) CacheOption {
+	return func(opts *Options) error {
+		opts.View.Namespaces = namespaces
+		return nil
+	}
+}
+
+// WithSelector returns a CacheOption that restricts the cache's ListWatch for obj's GVK to the
+// given selector, in place of any selector set by an earlier option for that GVK.
+func WithSelector(obj client.Object, sel ObjectSelector) CacheOption {
+	return func(opts *Options) error {
+		return opts.setByObject(obj, func(o *ObjectOptions) {
+			o.Label = sel.Label
+			o.Field = sel.Field
+		})
+	}
+}
+
+// WithTransform returns a CacheOption that applies fn to obj's GVK when objects of that GVK are
+// about to be committed to cache, in place of any transform set by an earlier option for that GVK.
+func WithTransform(obj client.Object, fn toolscache.TransformFunc) CacheOption {
+	return func(opts *Options) error {
+		return opts.setByObject(obj, func(o *ObjectOptions) {
+			o.Transform = fn
+		})
+	}
+}
+
+// WithDisableDeepCopy returns a CacheOption that disables deep-copying objects of obj's GVK
+// during get or list from the cache.
+// Be very careful with this, when enabled you must DeepCopy any object before mutating it,
+// otherwise you will mutate the object in the cache.
+func WithDisableDeepCopy(obj client.Object) CacheOption {
+	return func(opts *Options) error {
+		disable := true
+		return opts.setByObject(obj, func(o *ObjectOptions) {
+			o.UnsafeDisableDeepCopy = &disable
+		})
 	}
-	selectorsByGVK, err := convertToByGVK(opts.View.ByObject.Selectors, opts.View.DefaultSelector, opts.Scheme)
+}
+
+// gvkFor resolves obj's GroupVersionKind using opts.Scheme, falling back to the default
+// client-go Scheme if opts.Scheme hasn't been set yet. CacheOptions like WithSelector run
+// before defaultOpts, so opts.Scheme is frequently still nil at this point; defaultOpts falls
+// back to the same Scheme if the caller never sets one explicitly, so this matches what the
+// GVK would resolve to once the Cache is actually built.
+func (opts *Options) gvkFor(obj client.Object) (schema.GroupVersionKind, error) {
+	s := opts.Scheme
+	if s == nil {
+		s = scheme.Scheme
+	}
+	return apiutil.GVKForObject(obj, s)
+}
+
+// byObjectKeyForGVK returns the key already present in opts.ByObject whose GVK matches gvk, if
+// any.
+func (opts *Options) byObjectKeyForGVK(gvk schema.GroupVersionKind) (client.Object, bool) {
+	for existing := range opts.ByObject {
+		existingGVK, err := opts.gvkFor(existing)
+		if err != nil {
+			continue
+		}
+		if existingGVK == gvk {
+			return existing, true
+		}
+	}
+	return nil, false
+}
+
+// setByObject applies mutate to the ObjectOptions for obj's GVK, initializing the ByObject map
+// if necessary. It resolves obj to its GVK and reuses whichever existing ByObject key already
+// represents that GVK, rather than keying on obj's pointer identity: composing WithSelector,
+// WithTransform and WithDisableDeepCopy for "the same" GVK through separate object allocations
+// (as cache.NewWithCacheOptions(cfg, cache.WithSelector(&corev1.Pod{}, sel),
+// cache.WithTransform(&corev1.Pod{}, fn)) does) would otherwise land as two distinct, unmerged
+// map entries.
+func (opts *Options) setByObject(obj client.Object, mutate func(*ObjectOptions)) error {
+	gvk, err := opts.gvkFor(obj)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	key := obj
+	var o ObjectOptions
+	if existing, ok := opts.byObjectKeyForGVK(gvk); ok {
+		key = existing
+		o = opts.ByObject[existing]
+	}
+	mutate(&o)
+	if opts.ByObject == nil {
+		opts.ByObject = map[client.Object]ObjectOptions{}
 	}
-	disableDeepCopyByGVK, err := convertToDisableDeepCopyByGVK(opts.View.ByObject.UnsafeDisableDeepCopy, opts.Scheme)
+	opts.ByObject[key] = o
+	return nil
+}
+
+// newCache builds the Cache described by a fully-assembled Options value.
+func newCache(config *rest.Config, opts Options) (Cache, error) {
+	opts, err := defaultOpts(config, opts)
 	if err != nil {
 		return nil, err
 	}
-	transformers, err := convertToByGVK(opts.View.ByObject.Transform, opts.View.DefaultTransform, opts.Scheme)
+
+	byGVK, err := byObjectToByGVKWithDefaults(opts)
 	if err != nil {
 		return nil, err
 	}
-	transformByGVK := internal.TransformFuncByGVKFromMap(transformers)
 
 	internalSelectorsByGVK := internal.SelectorsByGVK{}
-	for gvk, selector := range selectorsByGVK {
-		internalSelectorsByGVK[gvk] = internal.Selector(selector)
+	disableDeepCopyByGVK := internal.DisableDeepCopyByGVK{}
+	transformers := map[schema.GroupVersionKind]toolscache.TransformFunc{}
+	for gvk, o := range byGVK {
+		internalSelectorsByGVK[gvk] = internal.Selector{Label: o.Label, Field: o.Field}
+		if o.UnsafeDisableDeepCopy != nil {
+			disableDeepCopyByGVK[gvk] = *o.UnsafeDisableDeepCopy
+		}
+		transformers[gvk] = o.Transform
+	}
+	transformByGVK := internal.TransformFuncByGVKFromMap(transformers)
+
+	resyncPeriodByGVK, err := resyncPeriodOverridesByGVK(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(opts.View.Namespaces) == 0 {
 		opts.View.Namespaces = []string{metav1.NamespaceAll}
 	}
 
-	if len(opts.View.Namespaces) > 1 {
-		return newMultiNamespaceCache(config, opts)
+	// A per-GVK namespace override in ByObject is a degenerate case of a cache-wide
+	// multi-namespace View: both need one informer set per distinct namespace, with each GVK
+	// routed to whichever of those sets it is configured to watch. perGVKNamespacedCache
+	// handles both.
+	if len(opts.View.Namespaces) > 1 || hasPerGVKNamespaces(opts.ByObject) {
+		return newPerGVKNamespacedCache(config, opts, byGVK)
 	}
 
 	return &informerCache{
@@ -221,224 +451,424 @@ func New(config *rest.Config, opts Options) (Cache, error) {
 				Selectors:       internalSelectorsByGVK,
 				DisableDeepCopy: disableDeepCopyByGVK,
 				Transformers:    transformByGVK,
+				// ResyncPeriods overrides ResyncPeriod above on a per-GVK basis, still jittered
+				// by ~10% so informers for the same GVK don't all relist simultaneously. This
+				// lets callers keep expensive types like Node on the cache-wide default while
+				// forcing fast-changing CRDs onto a much shorter cadence.
+				ResyncPeriods: resyncPeriodByGVK,
 			},
 		}),
 	}, nil
 }
 
-// BuilderWithOptions returns a Cache constructor that will build a cache
-// honoring the options argument, this is useful to specify options like
-// SelectorsByObject
-// WARNING: If SelectorsByObject is specified, filtered out resources are not
-// returned.
-// WARNING: If UnsafeDisableDeepCopy is enabled, you must DeepCopy any object
-// returned from cache get/list before mutating it.
-func BuilderWithOptions(options Options) NewCacheFunc {
-	return func(config *rest.Config, inherited Options) (Cache, error) {
-		var err error
-		inherited, err = defaultOpts(config, inherited)
-		if err != nil {
-			return nil, err
-		}
-		options, err = defaultOpts(config, options)
-		if err != nil {
-			return nil, err
-		}
-		combined, err := options.inheritFrom(inherited)
-		if err != nil {
-			return nil, err
+// hasPerGVKNamespaces reports whether any object has been explicitly configured with its own set
+// of namespaces to watch, independent of the cache-wide View.Namespaces. It must be checked
+// against the raw ByObject map, not byObjectToByGVKWithDefaults' result: that function merges
+// View.Namespaces into every entry that didn't set its own, which would otherwise make every
+// ByObject entry look like a per-GVK override the moment any namespace restriction is in play.
+func hasPerGVKNamespaces(byObject map[client.Object]ObjectOptions) bool {
+	for _, o := range byObject {
+		if len(o.Namespaces) > 0 {
+			return true
 		}
-		return New(config, *combined)
 	}
+	return false
 }
 
-func (options Options) inheritFrom(inherited Options) (*Options, error) {
-	var (
-		combined Options
-		err      error
-	)
-	combined.Scheme = combineScheme(inherited.Scheme, options.Scheme)
-	combined.Mapper = selectMapper(inherited.Mapper, options.Mapper)
-	combined.ResyncEvery = selectResync(inherited.ResyncEvery, options.ResyncEvery)
-	combined.View.Namespaces = selectNamespaces(inherited.View.Namespaces, options.View.Namespaces)
-	combined.View.ByObject.Selectors, combined.View.DefaultSelector, err = combineSelectors(inherited, options, combined.Scheme)
-	if err != nil {
-		return nil, err
+// newPerGVKNamespacedCache builds a Cache that fans out into one informerCache per distinct
+// namespace referenced by byGVK (either via a per-GVK ObjectOptions.Namespaces override or the
+// cache-wide opts.View.Namespaces), and routes every Cache and Informers call to the namespace
+// set configured for the object's GVK.
+func newPerGVKNamespacedCache(config *rest.Config, opts Options, byGVK map[schema.GroupVersionKind]ObjectOptions) (Cache, error) {
+	internalSelectorsByGVK := internal.SelectorsByGVK{}
+	disableDeepCopyByGVK := internal.DisableDeepCopyByGVK{}
+	transformers := map[schema.GroupVersionKind]toolscache.TransformFunc{}
+	namespacesByGVK := map[schema.GroupVersionKind][]string{}
+	distinctNamespaces := map[string]struct{}{}
+	for gvk, o := range byGVK {
+		internalSelectorsByGVK[gvk] = internal.Selector{Label: o.Label, Field: o.Field}
+		if o.UnsafeDisableDeepCopy != nil {
+			disableDeepCopyByGVK[gvk] = *o.UnsafeDisableDeepCopy
+		}
+		transformers[gvk] = o.Transform
+
+		namespaces := o.Namespaces
+		if len(namespaces) == 0 {
+			namespaces = []string{metav1.NamespaceAll}
+		}
+		namespacesByGVK[gvk] = namespaces
+		for _, ns := range namespaces {
+			distinctNamespaces[ns] = struct{}{}
+		}
 	}
-	combined.View.ByObject.UnsafeDisableDeepCopy, err = combineUnsafeDeepCopy(inherited, options, combined.Scheme)
+
+	resyncPeriodByGVK, err := resyncPeriodOverridesByGVK(opts)
 	if err != nil {
 		return nil, err
 	}
-	combined.View.ByObject.Transform, combined.View.DefaultTransform, err = combineTransforms(inherited, options, combined.Scheme)
-	if err != nil {
-		return nil, err
+
+	namespaceCaches := make(map[string]Cache, len(distinctNamespaces))
+	for ns := range distinctNamespaces {
+		nsConfig := config
+		nsSelectorsByGVK := internalSelectorsByGVK
+		nsDisableDeepCopyByGVK := disableDeepCopyByGVK
+		nsTransformers := transformers
+		if override, ok := opts.NamespaceConfigs[ns]; ok {
+			overridden, err := applyNamespaceConfigOverride(config, override, opts.Scheme, internalSelectorsByGVK, disableDeepCopyByGVK, transformers)
+			if err != nil {
+				return nil, err
+			}
+			nsConfig, nsSelectorsByGVK, nsDisableDeepCopyByGVK, nsTransformers = overridden.config, overridden.selectorsByGVK, overridden.disableDeepCopyByGVK, overridden.transformers
+		}
+		namespaceCaches[ns] = &informerCache{
+			scheme: opts.Scheme,
+			Informers: internal.NewInformers(nsConfig, &internal.InformersOpts{
+				HTTPClient:   opts.HTTPClient,
+				Scheme:       opts.Scheme,
+				Mapper:       opts.Mapper,
+				ResyncPeriod: *opts.ResyncEvery,
+				Namespace:    ns,
+				ByGVK: internal.InformersOptsByGVK{
+					Selectors:       nsSelectorsByGVK,
+					DisableDeepCopy: nsDisableDeepCopyByGVK,
+					Transformers:    internal.TransformFuncByGVKFromMap(nsTransformers),
+					ResyncPeriods:   resyncPeriodByGVK,
+				},
+			}),
+		}
 	}
-	return &combined, nil
+
+	return &perGVKNamespacedCache{
+		scheme:          opts.Scheme,
+		namespaceCaches: namespaceCaches,
+		namespacesByGVK: namespacesByGVK,
+	}, nil
 }
 
-func combineScheme(schemes ...*runtime.Scheme) *runtime.Scheme {
-	var out *runtime.Scheme
-	for _, sch := range schemes {
-		if sch == nil {
-			continue
+// namespaceOverrideResult holds the effective per-namespace informer configuration produced by
+// layering a NamespaceOptions override on top of the cache-wide and per-GVK defaults.
+type namespaceOverrideResult struct {
+	config               *rest.Config
+	selectorsByGVK       internal.SelectorsByGVK
+	disableDeepCopyByGVK internal.DisableDeepCopyByGVK
+	transformers         map[schema.GroupVersionKind]toolscache.TransformFunc
+}
+
+// applyNamespaceConfigOverride layers a single NamespaceOptions entry from Options.NamespaceConfigs
+// on top of the cache-wide per-GVK selectors, deep-copy and transform settings, for the namespace
+// that override applies to. It never mutates its map arguments.
+func applyNamespaceConfigOverride(
+	config *rest.Config,
+	override NamespaceOptions,
+	scheme *runtime.Scheme,
+	selectorsByGVK internal.SelectorsByGVK,
+	disableDeepCopyByGVK internal.DisableDeepCopyByGVK,
+	transformers map[schema.GroupVersionKind]toolscache.TransformFunc,
+) (namespaceOverrideResult, error) {
+	nsSelectorsByGVK := make(internal.SelectorsByGVK, len(selectorsByGVK))
+	for gvk, sel := range selectorsByGVK {
+		nsSelectorsByGVK[gvk] = sel
+	}
+	for obj, sel := range override.Selectors {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return namespaceOverrideResult{}, err
 		}
-		for gvk, t := range sch.AllKnownTypes() {
-			if out == nil {
-				out = runtime.NewScheme()
-			}
-			out.AddKnownTypeWithName(gvk, reflect.New(t).Interface().(runtime.Object))
+		nsSelectorsByGVK[gvk] = internal.Selector{Label: sel.Label, Field: sel.Field}
+	}
+
+	nsDisableDeepCopyByGVK := make(internal.DisableDeepCopyByGVK, len(disableDeepCopyByGVK))
+	for gvk, disable := range disableDeepCopyByGVK {
+		nsDisableDeepCopyByGVK[gvk] = disable
+	}
+	if override.UnsafeDisableDeepCopy != nil {
+		for gvk := range nsSelectorsByGVK {
+			nsDisableDeepCopyByGVK[gvk] = *override.UnsafeDisableDeepCopy
 		}
 	}
-	return out
-}
 
-func selectMapper(def, override meta.RESTMapper) meta.RESTMapper {
-	if override != nil {
-		return override
+	nsTransformers := make(map[schema.GroupVersionKind]toolscache.TransformFunc, len(transformers))
+	for gvk, fn := range transformers {
+		nsTransformers[gvk] = fn
+	}
+	if override.Transform != nil {
+		for gvk := range nsTransformers {
+			nsTransformers[gvk] = override.Transform
+		}
 	}
-	return def
+
+	nsConfig := config
+	if override.Config != nil {
+		nsConfig = override.Config
+	}
+
+	return namespaceOverrideResult{
+		config:               nsConfig,
+		selectorsByGVK:       nsSelectorsByGVK,
+		disableDeepCopyByGVK: nsDisableDeepCopyByGVK,
+		transformers:         nsTransformers,
+	}, nil
 }
 
-func selectResync(def, override *time.Duration) *time.Duration {
-	if override != nil {
-		return override
+// perGVKNamespacedCache is a Cache that delegates to one informerCache per namespace, routing each
+// call by the GVK of the object (or objects) involved to whichever namespaceCaches entries that
+// GVK is configured to watch.
+type perGVKNamespacedCache struct {
+	scheme          *runtime.Scheme
+	namespaceCaches map[string]Cache
+	namespacesByGVK map[schema.GroupVersionKind][]string
+}
+
+var _ Cache = &perGVKNamespacedCache{}
+
+// namespacesFor returns the namespaces configured for gvk, falling back to the cache-wide
+// default recorded under the zero-value GVK.
+func (c *perGVKNamespacedCache) namespacesFor(gvk schema.GroupVersionKind) []string {
+	if namespaces, ok := c.namespacesByGVK[gvk]; ok {
+		return namespaces
 	}
-	return def
+	return c.namespacesByGVK[schema.GroupVersionKind{}]
 }
 
-func selectNamespaces(def, override []string) []string {
-	if len(override) > 0 {
-		return override
+// cacheForNamespace returns the namespaceCaches entry that watches namespace for gvk, or an
+// error if gvk isn't configured to watch it.
+func (c *perGVKNamespacedCache) cacheForNamespace(gvk schema.GroupVersionKind, namespace string) (Cache, error) {
+	for _, ns := range c.namespacesFor(gvk) {
+		if ns == metav1.NamespaceAll || ns == namespace {
+			return c.namespaceCaches[ns], nil
+		}
 	}
-	return def
+	return nil, fmt.Errorf("cache is not configured to watch namespace %q for %s", namespace, gvk)
 }
 
-func combineSelectors(inherited, options Options, scheme *runtime.Scheme) (SelectorsByObject, ObjectSelector, error) {
-	// Selectors are combined via logical AND.
-	//  - Combined label selector is a union of the selectors requirements from both sets of options.
-	//  - Combined field selector uses fields.AndSelectors with the combined list of non-nil field selectors
-	//    defined in both sets of options.
-	//
-	// There is a bunch of complexity here because we need to convert to SelectorsByGVK
-	// to be able to match keys between options and inherited and then convert back to SelectorsByObject
-	optionsSelectorsByGVK, err := convertToByGVK(options.View.ByObject.Selectors, options.View.DefaultSelector, scheme)
+func (c *perGVKNamespacedCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
 	if err != nil {
-		return nil, ObjectSelector{}, err
+		return err
 	}
-	inheritedSelectorsByGVK, err := convertToByGVK(inherited.View.ByObject.Selectors, inherited.View.DefaultSelector, inherited.Scheme)
+	cache, err := c.cacheForNamespace(gvk, key.Namespace)
 	if err != nil {
-		return nil, ObjectSelector{}, err
-	}
-
-	for gvk, inheritedSelector := range inheritedSelectorsByGVK {
-		optionsSelectorsByGVK[gvk] = combineSelector(inheritedSelector, optionsSelectorsByGVK[gvk])
+		return err
 	}
-	return convertToByObject(optionsSelectorsByGVK, scheme)
+	return cache.Get(ctx, key, obj, opts...)
 }
 
-func combineSelector(selectors ...ObjectSelector) ObjectSelector {
-	ls := make([]labels.Selector, 0, len(selectors))
-	fs := make([]fields.Selector, 0, len(selectors))
-	for _, s := range selectors {
-		ls = append(ls, s.Label)
-		fs = append(fs, s.Field)
+func (c *perGVKNamespacedCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	gvk, err := apiutil.GVKForObject(list, c.scheme)
+	if err != nil {
+		return err
 	}
-	return ObjectSelector{
-		Label: combineLabelSelectors(ls...),
-		Field: combineFieldSelectors(fs...),
+	// List types carry a "FooList" Kind; the per-object configuration above is keyed by the
+	// singular "Foo" GVK.
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	if listOpts.Namespace != metav1.NamespaceAll {
+		cache, err := c.cacheForNamespace(gvk, listOpts.Namespace)
+		if err != nil {
+			return err
+		}
+		return cache.List(ctx, list, opts...)
 	}
-}
 
-func combineLabelSelectors(ls ...labels.Selector) labels.Selector {
-	var combined labels.Selector
-	for _, l := range ls {
-		if l == nil {
-			continue
+	allItems, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	for _, ns := range c.namespacesFor(gvk) {
+		nsList := list.DeepCopyObject().(client.ObjectList)
+		if err := c.namespaceCaches[ns].List(ctx, nsList, opts...); err != nil {
+			return err
 		}
-		if combined == nil {
-			combined = labels.NewSelector()
+		items, err := meta.ExtractList(nsList)
+		if err != nil {
+			return err
 		}
-		reqs, _ := l.Requirements()
-		combined = combined.Add(reqs...)
+		allItems = append(allItems, items...)
+	}
+	return meta.SetList(list, allItems)
+}
+
+func (c *perGVKNamespacedCache) GetInformer(ctx context.Context, obj client.Object) (Informer, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return nil, err
 	}
-	return combined
+	return c.getInformerForGVK(ctx, gvk, func(ctx context.Context, cache Cache) (Informer, error) {
+		return cache.GetInformer(ctx, obj)
+	})
+}
+
+func (c *perGVKNamespacedCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (Informer, error) {
+	return c.getInformerForGVK(ctx, gvk, func(ctx context.Context, cache Cache) (Informer, error) {
+		return cache.GetInformerForKind(ctx, gvk)
+	})
 }
 
-func combineFieldSelectors(fs ...fields.Selector) fields.Selector {
-	nonNil := fs[:0]
-	for _, f := range fs {
-		if f == nil {
+// getInformerForGVK fetches the per-namespace informer(s) for gvk, in parallel since each
+// namespace's informer is created and synced independently.
+func (c *perGVKNamespacedCache) getInformerForGVK(ctx context.Context, gvk schema.GroupVersionKind, get func(context.Context, Cache) (Informer, error)) (Informer, error) {
+	namespaces := c.namespacesFor(gvk)
+
+	type result struct {
+		namespace string
+		informer  Informer
+		err       error
+	}
+	resultCh := make(chan result, len(namespaces))
+	for _, ns := range namespaces {
+		go func(ns string) {
+			informer, err := get(ctx, c.namespaceCaches[ns])
+			resultCh <- result{namespace: ns, informer: informer, err: err}
+		}(ns)
+	}
+
+	informers := make(map[string]Informer, len(namespaces))
+	var firstErr error
+	for range namespaces {
+		res := <-resultCh
+		if res.err != nil && firstErr == nil {
+			firstErr = res.err
 			continue
 		}
-		nonNil = append(nonNil, f)
+		informers[res.namespace] = res.informer
 	}
-	if len(nonNil) == 0 {
-		return nil
+	if firstErr != nil {
+		return nil, firstErr
 	}
-	if len(nonNil) == 1 {
-		return nonNil[0]
+	if len(informers) == 1 {
+		for _, informer := range informers {
+			return informer, nil
+		}
 	}
-	return fields.AndSelectors(nonNil...)
+	return &perGVKNamespacedInformer{namespaceToInformer: informers}, nil
 }
 
-func combineUnsafeDeepCopy(inherited, options Options, scheme *runtime.Scheme) (DisableDeepCopyByObject, error) {
-	// UnsafeDisableDeepCopyByObject is combined via precedence. Only if a value for a particular GVK is unset
-	// in options will a value from inherited be used.
-	optionsDisableDeepCopyByGVK, err := convertToDisableDeepCopyByGVK(options.View.ByObject.UnsafeDisableDeepCopy, options.Scheme)
-	if err != nil {
-		return nil, err
+func (c *perGVKNamespacedCache) Start(ctx context.Context) error {
+	errCh := make(chan error, len(c.namespaceCaches))
+	for _, cache := range c.namespaceCaches {
+		go func(cache Cache) {
+			errCh <- cache.Start(ctx)
+		}(cache)
 	}
-	inheritedDisableDeepCopyByGVK, err := convertToDisableDeepCopyByGVK(inherited.View.ByObject.UnsafeDisableDeepCopy, inherited.Scheme)
-	if err != nil {
-		return nil, err
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
 	}
+}
 
-	for gvk, inheritedDeepCopy := range inheritedDisableDeepCopyByGVK {
-		if _, ok := optionsDisableDeepCopyByGVK[gvk]; !ok {
-			if optionsDisableDeepCopyByGVK == nil {
-				optionsDisableDeepCopyByGVK = map[schema.GroupVersionKind]bool{}
-			}
-			optionsDisableDeepCopyByGVK[gvk] = inheritedDeepCopy
+func (c *perGVKNamespacedCache) WaitForCacheSync(ctx context.Context) bool {
+	synced := true
+	for _, cache := range c.namespaceCaches {
+		if !cache.WaitForCacheSync(ctx) {
+			synced = false
 		}
 	}
-	return convertToDisableDeepCopyByObject(optionsDisableDeepCopyByGVK, scheme)
+	return synced
 }
 
-func combineTransforms(inherited, options Options, scheme *runtime.Scheme) (TransformByObject, toolscache.TransformFunc, error) {
-	// Transform functions are combined via chaining. If both inherited and options define a transform
-	// function, the transform function from inherited will be called first, and the transform function from
-	// options will be called second.
-	optionsTransformByGVK, err := convertToByGVK(options.View.ByObject.Transform, options.View.DefaultTransform, options.Scheme)
+func (c *perGVKNamespacedCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
-	inheritedTransformByGVK, err := convertToByGVK(inherited.View.ByObject.Transform, inherited.View.DefaultTransform, inherited.Scheme)
-	if err != nil {
-		return nil, nil, err
+	for _, ns := range c.namespacesFor(gvk) {
+		if err := c.namespaceCaches[ns].IndexField(ctx, obj, field, extractValue); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// perGVKNamespacedInformer fans an Informer's methods out across one real per-namespace Informer,
+// for a GVK that is configured to watch more than one namespace.
+type perGVKNamespacedInformer struct {
+	namespaceToInformer map[string]Informer
+}
+
+var _ Informer = &perGVKNamespacedInformer{}
+
+// perGVKNamespacedHandlerRegistration is the registration handle returned by
+// perGVKNamespacedInformer.AddEventHandler(WithResyncPeriod), recording the per-namespace
+// registration so RemoveEventHandler can undo it on every underlying informer.
+type perGVKNamespacedHandlerRegistration struct {
+	registrations map[string]toolscache.ResourceEventHandlerRegistration
+}
 
-	for gvk, inheritedTransform := range inheritedTransformByGVK {
-		if optionsTransformByGVK == nil {
-			optionsTransformByGVK = map[schema.GroupVersionKind]toolscache.TransformFunc{}
+func (i *perGVKNamespacedInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	registrations := make(map[string]toolscache.ResourceEventHandlerRegistration, len(i.namespaceToInformer))
+	for ns, informer := range i.namespaceToInformer {
+		reg, err := informer.AddEventHandler(handler)
+		if err != nil {
+			return nil, err
 		}
-		optionsTransformByGVK[gvk] = combineTransform(inheritedTransform, optionsTransformByGVK[gvk])
+		registrations[ns] = reg
 	}
-	return convertToByObject(optionsTransformByGVK, scheme)
+	return &perGVKNamespacedHandlerRegistration{registrations: registrations}, nil
 }
 
-func combineTransform(inherited, current toolscache.TransformFunc) toolscache.TransformFunc {
-	if inherited == nil {
-		return current
-	}
-	if current == nil {
-		return inherited
-	}
-	return func(in interface{}) (interface{}, error) {
-		mid, err := inherited(in)
+func (i *perGVKNamespacedInformer) AddEventHandlerWithResyncPeriod(handler toolscache.ResourceEventHandler, resyncPeriod time.Duration) (toolscache.ResourceEventHandlerRegistration, error) {
+	registrations := make(map[string]toolscache.ResourceEventHandlerRegistration, len(i.namespaceToInformer))
+	for ns, informer := range i.namespaceToInformer {
+		reg, err := informer.AddEventHandlerWithResyncPeriod(handler, resyncPeriod)
 		if err != nil {
 			return nil, err
 		}
-		return current(mid)
+		registrations[ns] = reg
+	}
+	return &perGVKNamespacedHandlerRegistration{registrations: registrations}, nil
+}
+
+func (i *perGVKNamespacedInformer) RemoveEventHandler(handle toolscache.ResourceEventHandlerRegistration) error {
+	reg, ok := handle.(*perGVKNamespacedHandlerRegistration)
+	if !ok {
+		return fmt.Errorf("unexpected event handler registration type %T", handle)
+	}
+	for ns, informer := range i.namespaceToInformer {
+		registration, ok := reg.registrations[ns]
+		if !ok {
+			continue
+		}
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *perGVKNamespacedInformer) AddIndexers(indexers toolscache.Indexers) error {
+	for _, informer := range i.namespaceToInformer {
+		if err := informer.AddIndexers(indexers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *perGVKNamespacedInformer) HasSynced() bool {
+	for _, informer := range i.namespaceToInformer {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// MultiNamespacedCacheBuilder returns a Cache constructor that will scope the cache to a list of
+// namespaces. Listing for all namespaces will list for all the namespaces that this cache is
+// scoped to.
+//
+// Deprecated: Use cache.New instead, setting Options.View.Namespaces to the desired namespaces.
+// New already dispatches to a multi-namespace cache whenever more than one namespace is
+// requested, whether via View.Namespaces or a per-GVK override in ByObject.
+func MultiNamespacedCacheBuilder(namespaces []string) NewCacheFunc {
+	return func(config *rest.Config, opts Options) (Cache, error) {
+		opts.View.Namespaces = namespaces
+		return newCache(config, opts)
 	}
 }
 
@@ -477,93 +907,86 @@ func defaultOpts(config *rest.Config, opts Options) (Options, error) {
 	return opts, nil
 }
 
-func convertToByGVK[T any](byObject map[client.Object]T, def T, scheme *runtime.Scheme) (map[schema.GroupVersionKind]T, error) {
-	byGVK := map[schema.GroupVersionKind]T{}
-	for object, value := range byObject {
+// byObjectToByGVK converts a ByObject map, keyed by a client.Object instance, to one keyed by
+// that object's GroupVersionKind, so that each entry can be merged with the top-level Options
+// defaults and handed to the internal informers by GVK.
+func byObjectToByGVK(byObject map[client.Object]ObjectOptions, scheme *runtime.Scheme) (map[schema.GroupVersionKind]ObjectOptions, error) {
+	byGVK := map[schema.GroupVersionKind]ObjectOptions{}
+	for object, o := range byObject {
 		gvk, err := apiutil.GVKForObject(object, scheme)
 		if err != nil {
 			return nil, err
 		}
-		byGVK[gvk] = value
+		byGVK[gvk] = o
 	}
-	byGVK[schema.GroupVersionKind{}] = def
 	return byGVK, nil
 }
 
-func convertToByObject[T any](byGVK map[schema.GroupVersionKind]T, scheme *runtime.Scheme) (map[client.Object]T, T, error) {
-	var byObject map[client.Object]T
-	def := byGVK[schema.GroupVersionKind{}]
-	for gvk, value := range byGVK {
-		if gvk == (schema.GroupVersionKind{}) {
-			continue
-		}
-		obj, err := scheme.New(gvk)
-		if err != nil {
-			return nil, def, err
-		}
-		cObj, ok := obj.(client.Object)
-		if !ok {
-			return nil, def, fmt.Errorf("object %T for GVK %q does not implement client.Object", obj, gvk)
-		}
-		if byObject == nil {
-			byObject = map[client.Object]T{}
-		}
-		byObject[cObj] = value
+// byObjectToByGVKWithDefaults is like byObjectToByGVK, but additionally records the top-level
+// Options defaults under the zero-value GVK, and merges those same defaults into every other
+// GVK's entry for whichever fields that entry left unset, so that a GVK which only overrides one
+// knob (say, Label) still inherits the cache-wide Transform, selector and resync behavior instead
+// of silently losing them.
+func byObjectToByGVKWithDefaults(opts Options) (map[schema.GroupVersionKind]ObjectOptions, error) {
+	byGVK, err := byObjectToByGVK(opts.ByObject, opts.Scheme)
+	if err != nil {
+		return nil, err
 	}
-	return byObject, def, nil
-}
-
-// DisableDeepCopyByObject associate a client.Object's GVK to disable DeepCopy during get or list from cache.
-type DisableDeepCopyByObject map[client.Object]bool
-
-var _ client.Object = &ObjectAll{}
-
-// ObjectAll is the argument to represent all objects' types.
-type ObjectAll struct {
-	client.Object
+	defaults := ObjectOptions{
+		Label:                 opts.View.DefaultSelector.Label,
+		Field:                 opts.View.DefaultSelector.Field,
+		Transform:             opts.Transform,
+		UnsafeDisableDeepCopy: opts.UnsafeDisableDeepCopy,
+		Namespaces:            opts.View.Namespaces,
+		ResyncPeriod:          opts.ResyncEvery,
+	}
+	for gvk, o := range byGVK {
+		byGVK[gvk] = applyObjectOptionsDefaults(o, defaults)
+	}
+	byGVK[schema.GroupVersionKind{}] = defaults
+	return byGVK, nil
 }
 
-func convertToDisableDeepCopyByGVK(disableDeepCopyByObject DisableDeepCopyByObject, scheme *runtime.Scheme) (internal.DisableDeepCopyByGVK, error) {
-	disableDeepCopyByGVK := internal.DisableDeepCopyByGVK{}
-	for obj, disable := range disableDeepCopyByObject {
-		switch obj.(type) {
-		case ObjectAll, *ObjectAll:
-			disableDeepCopyByGVK[internal.GroupVersionKindAll] = disable
-		default:
-			gvk, err := apiutil.GVKForObject(obj, scheme)
-			if err != nil {
-				return nil, err
-			}
-			disableDeepCopyByGVK[gvk] = disable
-		}
+// applyObjectOptionsDefaults fills in any field o left unset with the corresponding field from
+// defaults.
+func applyObjectOptionsDefaults(o, defaults ObjectOptions) ObjectOptions {
+	if o.Label == nil {
+		o.Label = defaults.Label
+	}
+	if o.Field == nil {
+		o.Field = defaults.Field
+	}
+	if o.Transform == nil {
+		o.Transform = defaults.Transform
+	}
+	if o.UnsafeDisableDeepCopy == nil {
+		o.UnsafeDisableDeepCopy = defaults.UnsafeDisableDeepCopy
+	}
+	if len(o.Namespaces) == 0 {
+		o.Namespaces = defaults.Namespaces
 	}
-	return disableDeepCopyByGVK, nil
+	if o.ResyncPeriod == nil {
+		o.ResyncPeriod = defaults.ResyncPeriod
+	}
+	return o
 }
 
-func convertToDisableDeepCopyByObject(byGVK internal.DisableDeepCopyByGVK, scheme *runtime.Scheme) (DisableDeepCopyByObject, error) {
-	var byObject DisableDeepCopyByObject
-	for gvk, value := range byGVK {
-		if byObject == nil {
-			byObject = DisableDeepCopyByObject{}
-		}
-		if gvk == (schema.GroupVersionKind{}) {
-			byObject[ObjectAll{}] = value
+// resyncPeriodOverridesByGVK builds the internal.ResyncPeriodByGVK passed alongside
+// internal.InformersOpts.ResyncPeriod. It only includes genuine per-GVK overrides from
+// opts.ByObject, not byObjectToByGVKWithDefaults' merged result, which would otherwise copy
+// opts.ResyncEvery into every GVK's entry and apply the cache-wide default twice — once as
+// InformersOpts.ResyncPeriod, once again (redundantly) via ResyncPeriods.
+func resyncPeriodOverridesByGVK(opts Options) (internal.ResyncPeriodByGVK, error) {
+	resyncPeriodByGVK := internal.ResyncPeriodByGVK{}
+	for obj, o := range opts.ByObject {
+		if o.ResyncPeriod == nil {
 			continue
 		}
-		obj, err := scheme.New(gvk)
+		gvk, err := apiutil.GVKForObject(obj, opts.Scheme)
 		if err != nil {
 			return nil, err
 		}
-		cObj, ok := obj.(client.Object)
-		if !ok {
-			return nil, fmt.Errorf("object %T for GVK %q does not implement client.Object", obj, gvk)
-		}
-
-		byObject[cObj] = value
+		resyncPeriodByGVK[gvk] = *o.ResyncPeriod
 	}
-	return byObject, nil
+	return resyncPeriodByGVK, nil
 }
-
-// TransformByObject associate a client.Object's GVK to a transformer function
-// to be applied when storing the object into the cache.
-type TransformByObject map[client.Object]toolscache.TransformFunc
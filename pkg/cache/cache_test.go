@@ -0,0 +1,258 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache/internal"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var podGVK = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+// fakeCache is a no-op Cache used to tell namespaceCaches entries apart by identity in tests
+// that only exercise routing, not the underlying Get/List/Informer behavior.
+type fakeCache struct{}
+
+var _ Cache = &fakeCache{}
+
+func (f *fakeCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return nil
+}
+
+func (f *fakeCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return nil
+}
+
+func (f *fakeCache) GetInformer(ctx context.Context, obj client.Object) (Informer, error) {
+	return nil, nil
+}
+
+func (f *fakeCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (Informer, error) {
+	return nil, nil
+}
+
+func (f *fakeCache) Start(ctx context.Context) error { return nil }
+
+func (f *fakeCache) WaitForCacheSync(ctx context.Context) bool { return true }
+
+func (f *fakeCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	return nil
+}
+
+func TestPerGVKNamespacedCacheNamespacesFor(t *testing.T) {
+	tests := map[string]struct {
+		namespacesByGVK map[schema.GroupVersionKind][]string
+		gvk             schema.GroupVersionKind
+		want            []string
+	}{
+		"uses the per-GVK override when one is configured": {
+			namespacesByGVK: map[schema.GroupVersionKind][]string{
+				podGVK:                    {"team-a"},
+				schema.GroupVersionKind{}: {"default"},
+			},
+			gvk:  podGVK,
+			want: []string{"team-a"},
+		},
+		"falls back to the cache-wide default for a GVK with no override": {
+			namespacesByGVK: map[schema.GroupVersionKind][]string{
+				schema.GroupVersionKind{}: {"default", "kube-system"},
+			},
+			gvk:  podGVK,
+			want: []string{"default", "kube-system"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &perGVKNamespacedCache{namespacesByGVK: tc.namespacesByGVK}
+			got := c.namespacesFor(tc.gvk)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("namespacesFor(%v) = %v, want %v", tc.gvk, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPerGVKNamespacedCacheForNamespace(t *testing.T) {
+	teamACache := &fakeCache{}
+	allCache := &fakeCache{}
+
+	t.Run("returns the cache watching the requested namespace", func(t *testing.T) {
+		c := &perGVKNamespacedCache{
+			namespaceCaches: map[string]Cache{"team-a": teamACache},
+			namespacesByGVK: map[schema.GroupVersionKind][]string{podGVK: {"team-a"}},
+		}
+		got, err := c.cacheForNamespace(podGVK, "team-a")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != teamACache {
+			t.Errorf("cacheForNamespace returned %v, want the team-a cache", got)
+		}
+	})
+
+	t.Run("matches a NamespaceAll entry against any requested namespace", func(t *testing.T) {
+		c := &perGVKNamespacedCache{
+			namespaceCaches: map[string]Cache{metav1.NamespaceAll: allCache},
+			namespacesByGVK: map[schema.GroupVersionKind][]string{podGVK: {metav1.NamespaceAll}},
+		}
+		got, err := c.cacheForNamespace(podGVK, "team-b")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != allCache {
+			t.Errorf("cacheForNamespace returned %v, want the NamespaceAll cache", got)
+		}
+	})
+
+	t.Run("errors when the GVK isn't configured to watch the requested namespace", func(t *testing.T) {
+		c := &perGVKNamespacedCache{
+			namespaceCaches: map[string]Cache{"team-a": teamACache},
+			namespacesByGVK: map[schema.GroupVersionKind][]string{podGVK: {"team-a"}},
+		}
+		if _, err := c.cacheForNamespace(podGVK, "team-b"); err == nil {
+			t.Fatal("expected an error for an unwatched namespace, got nil")
+		}
+	})
+}
+
+func TestApplyNamespaceConfigOverride(t *testing.T) {
+	secretGVK := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	baseSelectors := internal.SelectorsByGVK{
+		podGVK:    {Label: labels.Everything()},
+		secretGVK: {Label: labels.Everything()},
+	}
+	baseDisableDeepCopy := internal.DisableDeepCopyByGVK{podGVK: false, secretGVK: false}
+	baseTransform := func(i interface{}) (interface{}, error) { return i, nil }
+	baseTransformers := map[schema.GroupVersionKind]toolscache.TransformFunc{
+		podGVK:    baseTransform,
+		secretGVK: baseTransform,
+	}
+
+	t.Run("a per-object selector override only replaces that object's GVK", func(t *testing.T) {
+		podSelector := fields.OneTermEqualSelector("metadata.name", "override-me")
+		override := NamespaceOptions{
+			Selectors: map[client.Object]ObjectSelector{
+				&corev1.Pod{}: {Field: podSelector},
+			},
+		}
+		result, err := applyNamespaceConfigOverride(nil, override, clientgoscheme.Scheme, baseSelectors, baseDisableDeepCopy, baseTransformers)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.selectorsByGVK[podGVK].Field != podSelector {
+			t.Errorf("pod selector = %v, want %v", result.selectorsByGVK[podGVK].Field, podSelector)
+		}
+		if !reflect.DeepEqual(result.selectorsByGVK[secretGVK].Label, baseSelectors[secretGVK].Label) {
+			t.Errorf("secret selector was overwritten, want it unchanged by a Pod-only override")
+		}
+		if _, ok := baseSelectors[podGVK]; !ok || baseSelectors[podGVK].Field != nil {
+			t.Error("applyNamespaceConfigOverride mutated its selectorsByGVK argument")
+		}
+	})
+
+	t.Run("UnsafeDisableDeepCopy, when set, broadcasts to every configured GVK", func(t *testing.T) {
+		disable := true
+		override := NamespaceOptions{UnsafeDisableDeepCopy: &disable}
+		result, err := applyNamespaceConfigOverride(nil, override, clientgoscheme.Scheme, baseSelectors, baseDisableDeepCopy, baseTransformers)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for gvk := range baseSelectors {
+			if !result.disableDeepCopyByGVK[gvk] {
+				t.Errorf("disableDeepCopyByGVK[%v] = false, want true", gvk)
+			}
+		}
+		if baseDisableDeepCopy[podGVK] {
+			t.Error("applyNamespaceConfigOverride mutated its disableDeepCopyByGVK argument")
+		}
+	})
+
+	t.Run("Config, when unset, leaves the base rest.Config untouched", func(t *testing.T) {
+		result, err := applyNamespaceConfigOverride(nil, NamespaceOptions{}, clientgoscheme.Scheme, baseSelectors, baseDisableDeepCopy, baseTransformers)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.config != nil {
+			t.Errorf("config = %v, want the base config (nil) unchanged", result.config)
+		}
+	})
+}
+
+func TestWithSelectorAndWithTransformComposeForTheSameGVKAcrossSeparateObjectAllocations(t *testing.T) {
+	sel := ObjectSelector{Field: fields.OneTermEqualSelector("metadata.name", "web")}
+	fn := toolscache.TransformFunc(func(i interface{}) (interface{}, error) { return i, nil })
+
+	// Two distinct &corev1.Pod{} allocations, exactly as a caller composing WithSelector and
+	// WithTransform for "the same" GVK would naturally write.
+	var options Options
+	for _, opt := range []CacheOption{WithSelector(&corev1.Pod{}, sel), WithTransform(&corev1.Pod{}, fn)} {
+		if err := opt(&options); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(options.ByObject) != 1 {
+		t.Fatalf("ByObject has %d entries, want 1 (WithSelector and WithTransform should merge into the same GVK's entry)", len(options.ByObject))
+	}
+	for _, o := range options.ByObject {
+		if o.Field != sel.Field {
+			t.Errorf("ByObject entry lost the WithSelector field selector: got %v", o.Field)
+		}
+		if o.Transform == nil {
+			t.Error("ByObject entry lost the WithTransform transform func")
+		}
+	}
+}
+
+func TestWithDefaultsOnlyFillsGapsByGVKNotByObjectIdentity(t *testing.T) {
+	defaultSel := ObjectSelector{Label: labels.Everything()}
+	fn := toolscache.TransformFunc(func(i interface{}) (interface{}, error) { return i, nil })
+
+	var options Options
+	if err := WithTransform(&corev1.Pod{}, fn)(&options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A different &corev1.Pod{} allocation in the defaults' ByObject map.
+	defaults := Options{ByObject: map[client.Object]ObjectOptions{&corev1.Pod{}: {Label: defaultSel.Label}}}
+	if err := WithDefaults(defaults)(&options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(options.ByObject) != 1 {
+		t.Fatalf("ByObject has %d entries, want 1 (WithDefaults should recognize Pod is already configured)", len(options.ByObject))
+	}
+	for _, o := range options.ByObject {
+		if o.Transform == nil {
+			t.Error("WithDefaults overwrote the caller's WithTransform setting for an already-configured GVK")
+		}
+		if !reflect.DeepEqual(o.Label, defaultSel.Label) {
+			t.Errorf("WithDefaults didn't fill the caller's unset Label field: got %v, want %v", o.Label, defaultSel.Label)
+		}
+	}
+}